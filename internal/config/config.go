@@ -0,0 +1,71 @@
+package config
+
+import (
+	"os"
+	"path"
+)
+
+const (
+	// InstallMethodEnvKey lets operators pick how the adapter installs Istio:
+	// "istioctl" (default) shells out to the istioctl binary, "helm" renders
+	// the official Istio helm chart in-process instead.
+	InstallMethodEnvKey = "ISTIO_INSTALL_METHOD"
+
+	// InstallMethodIstioctl is the default, istioctl-binary-based install strategy.
+	InstallMethodIstioctl = "istioctl"
+	// InstallMethodHelm renders the official Istio helm chart instead of
+	// shelling out to istioctl.
+	InstallMethodHelm = "helm"
+
+	// IstioctlSigningKeyEnvKey holds the text of an ASCII-armored GPG public key used
+	// to verify the signature on downloaded istioctl release checksums.
+	// Signature verification is skipped when unset.
+	IstioctlSigningKeyEnvKey = "ISTIO_CTL_SIGNING_KEY"
+
+	// BinaryMirrorEnvKey points the istioctl binary provider at a mirror's
+	// base URL instead of GitHub releases. See mirrorBinaryProvider.
+	BinaryMirrorEnvKey = "ISTIO_BINARY_MIRROR"
+
+	// LocalBinaryPathEnvKey points the istioctl binary provider at a local
+	// filesystem directory (e.g. "/opt/istio/bin") with a pre-seeded
+	// istioctl binary, for fully offline installs.
+	LocalBinaryPathEnvKey = "ISTIO_LOCAL_BINARY_PATH"
+)
+
+// RootPath returns the directory meshery-istio uses to cache downloaded
+// binaries and charts.
+func RootPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		home = "."
+	}
+	return path.Join(home, ".meshery", "istio")
+}
+
+// InstallMethod returns the configured install strategy, defaulting to
+// InstallMethodIstioctl when ISTIO_INSTALL_METHOD is unset.
+func InstallMethod() string {
+	if method := os.Getenv(InstallMethodEnvKey); method != "" {
+		return method
+	}
+	return InstallMethodIstioctl
+}
+
+// IstioctlSigningKey returns the ASCII-armored GPG public key used to verify
+// istioctl release checksums, or an empty string if signature verification
+// is not configured.
+func IstioctlSigningKey() string {
+	return os.Getenv(IstioctlSigningKeyEnvKey)
+}
+
+// BinaryMirror returns the configured istioctl mirror base URL, or an empty
+// string if GitHub releases should be used directly.
+func BinaryMirror() string {
+	return os.Getenv(BinaryMirrorEnvKey)
+}
+
+// LocalBinaryPath returns the configured local directory to look for a
+// pre-seeded istioctl binary in, or an empty string if none is configured.
+func LocalBinaryPath() string {
+	return os.Getenv(LocalBinaryPathEnvKey)
+}
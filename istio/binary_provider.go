@@ -0,0 +1,142 @@
+package istio
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"runtime"
+
+	"github.com/layer5io/meshery-istio/internal/config"
+)
+
+// BinaryProvider abstracts over where the istioctl binary for a given
+// release actually comes from, so the install flow in install.go doesn't
+// care whether it's running against GitHub releases, a configurable mirror,
+// or a pre-seeded local path.
+type BinaryProvider interface {
+	// Name identifies the provider, for logging.
+	Name() string
+	// Resolve returns the path to an already-installed istioctl binary for
+	// version without fetching anything, or an error if none is installed.
+	Resolve(version string) (string, error)
+	// Fetch obtains the istioctl archive for version/platform/arch and
+	// returns a reader over its contents.
+	Fetch(ctx context.Context, version, platform, arch string) (io.ReadCloser, error)
+}
+
+// selectBinaryProvider picks a BinaryProvider based on the adapter's
+// configuration: a configured local path wins (fully offline installs),
+// then a configured mirror, falling back to GitHub releases.
+func selectBinaryProvider(binPath string) BinaryProvider {
+	if localPath := config.LocalBinaryPath(); localPath != "" {
+		return &localPathBinaryProvider{dir: localPath}
+	}
+	if mirror := config.BinaryMirror(); mirror != "" {
+		return &mirrorBinaryProvider{baseURL: mirror, binPath: binPath}
+	}
+	return &githubBinaryProvider{binPath: binPath}
+}
+
+// githubBinaryProvider is the original behavior: resolve a previously
+// downloaded binary under binPath, or fetch it from Istio's GitHub releases,
+// checksum-verified.
+type githubBinaryProvider struct {
+	binPath string
+}
+
+func (p *githubBinaryProvider) Name() string { return "github releases" }
+
+func (p *githubBinaryProvider) Resolve(version string) (string, error) {
+	executable := path.Join(p.binPath, istioctlFilename(version, runtime.GOOS))
+	if _, err := os.Stat(executable); err == nil {
+		return executable, nil
+	}
+	return "", fmt.Errorf("istioctl %s not found under %s", version, p.binPath)
+}
+
+func (p *githubBinaryProvider) Fetch(ctx context.Context, version, platform, arch string) (io.ReadCloser, error) {
+	return downloadBinary(ctx, platform, arch, version)
+}
+
+// mirrorBinaryProvider fetches istioctl archives from a configurable HTTP
+// mirror instead of GitHub, mirroring assetURL's naming scheme against
+// baseURL. Unlike githubBinaryProvider, it does not checksum-verify what it
+// fetches: mirrors aren't required to publish the checksum files GitHub
+// releases do.
+type mirrorBinaryProvider struct {
+	baseURL string
+	binPath string
+}
+
+func (p *mirrorBinaryProvider) Name() string { return "mirror " + p.baseURL }
+
+func (p *mirrorBinaryProvider) Resolve(version string) (string, error) {
+	executable := path.Join(p.binPath, istioctlFilename(version, runtime.GOOS))
+	if _, err := os.Stat(executable); err == nil {
+		return executable, nil
+	}
+	return "", fmt.Errorf("istioctl %s not found under %s", version, p.binPath)
+}
+
+func (p *mirrorBinaryProvider) Fetch(ctx context.Context, version, platform, arch string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, mirrorAssetURL(p.baseURL, platform, arch, version), nil)
+	if err != nil {
+		return nil, ErrDownloadBinary(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, ErrDownloadBinary(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, ErrDownloadBinary(fmt.Errorf("bad status: %s", resp.Status))
+	}
+	return resp.Body, nil
+}
+
+// mirrorAssetURL mirrors assetURL's release-asset naming scheme against a
+// configurable base URL.
+func mirrorAssetURL(baseURL, platform, arch, release string) string {
+	switch platform {
+	case "darwin":
+		osxName := "osx"
+		if arch == "arm64" {
+			osxName = "osx-arm64"
+		}
+		return fmt.Sprintf("%s/%s/istioctl-%s-%s.tar.gz", baseURL, release, release, osxName)
+	case "windows":
+		return fmt.Sprintf("%s/%s/istioctl-%s-win.zip", baseURL, release, release)
+	default:
+		return fmt.Sprintf("%s/%s/istioctl-%s-%s-%s.tar.gz", baseURL, release, release, platform, arch)
+	}
+}
+
+// localPathBinaryProvider serves a pre-seeded istioctl binary from a local
+// filesystem path, for fully offline installs where neither GitHub nor a
+// mirror is reachable.
+type localPathBinaryProvider struct {
+	dir string
+}
+
+func (p *localPathBinaryProvider) Name() string { return "local path " + p.dir }
+
+func (p *localPathBinaryProvider) Resolve(version string) (string, error) {
+	platformIstioctl := "istioctl"
+	if runtime.GOOS == "windows" {
+		platformIstioctl += ".exe"
+	}
+	for _, name := range []string{istioctlFilename(version, runtime.GOOS), platformIstioctl} {
+		candidate := path.Join(p.dir, name)
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("no istioctl binary found under %s", p.dir)
+}
+
+func (p *localPathBinaryProvider) Fetch(_ context.Context, version, _, _ string) (io.ReadCloser, error) {
+	return nil, ErrDownloadBinary(fmt.Errorf("istioctl %s not found under local path %s; the local-path provider does not fetch binaries", version, p.dir))
+}
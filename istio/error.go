@@ -0,0 +1,98 @@
+package istio
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+var (
+	// ErrMeshConfigCode is the error code for ErrMeshConfig
+	ErrMeshConfigCode = "1000"
+	// ErrInstallIstioCode is the error code for ErrInstallIstio
+	ErrInstallIstioCode = "1001"
+	// ErrFetchManifestCode is the error code for ErrFetchManifest
+	ErrFetchManifestCode = "1002"
+	// ErrDownloadBinaryCode is the error code for ErrDownloadBinary
+	ErrDownloadBinaryCode = "1003"
+	// ErrInstallBinaryCode is the error code for ErrInstallBinary
+	ErrInstallBinaryCode = "1004"
+	// ErrTarXZFCode is the error code for ErrTarXZF
+	ErrTarXZFCode = "1005"
+	// ErrUnzipFileCode is the error code for ErrUnzipFile
+	ErrUnzipFileCode = "1006"
+	// ErrFetchHelmChartCode is the error code for ErrFetchHelmChart
+	ErrFetchHelmChartCode = "1007"
+	// ErrRenderHelmChartCode is the error code for ErrRenderHelmChart
+	ErrRenderHelmChartCode = "1008"
+	// ErrChecksumMismatchCode is the error code for ErrChecksumMismatch
+	ErrChecksumMismatchCode = "1009"
+	// ErrSignatureMismatchCode is the error code for ErrSignatureMismatch
+	ErrSignatureMismatchCode = "1010"
+)
+
+// ErrMeshConfig is the error when the adapter is unable to load its mesh config
+func ErrMeshConfig(err error) error {
+	return errors.New(ErrMeshConfigCode, errors.Alert, []string{"Error configuring mesh"}, []string{err.Error()}, []string{"Mesh config passed to the adapter is invalid"}, []string{"Make sure a valid mesh config is passed to the adapter"})
+}
+
+// ErrInstallIstio is the error for installing istio
+func ErrInstallIstio(err error) error {
+	return errors.New(ErrInstallIstioCode, errors.Alert, []string{"Error installing istio"}, []string{err.Error()}, []string{"Installation failure can occur due to network failure or Kubernetes cluster not being reachable"}, []string{"Ensure the Kubernetes cluster is reachable and the network connection is stable"})
+}
+
+// ErrFetchManifest is the error for fetching manifest
+func ErrFetchManifest(err error, des string) error {
+	return errors.New(ErrFetchManifestCode, errors.Alert, []string{"Error fetching manifest"}, []string{err.Error(), des}, []string{"istioctl might not be reachable or the requested version is invalid"}, []string{"Make sure the version being installed is a valid, released Istio version"})
+}
+
+// ErrDownloadBinary is the error for downloading istioctl binary
+func ErrDownloadBinary(err error) error {
+	return errors.New(ErrDownloadBinaryCode, errors.Alert, []string{"Error downloading istioctl binary"}, []string{err.Error()}, []string{"The requested release asset may not exist, or GitHub releases may be unreachable"}, []string{"Check network connectivity to github.com and that the requested version exists"})
+}
+
+// ErrInstallBinary is the error for installing istioctl binary
+func ErrInstallBinary(err error) error {
+	return errors.New(ErrInstallBinaryCode, errors.Alert, []string{"Error installing istioctl binary"}, []string{err.Error()}, []string{"The downloaded archive may be corrupt or the install path may not be writable"}, []string{"Ensure the adapter's root config path is writable"})
+}
+
+// ErrTarXZF is the error for extracting tar.gz archive
+func ErrTarXZF(err error) error {
+	return errors.New(ErrTarXZFCode, errors.Alert, []string{"Error extracting tar.gz archive"}, []string{err.Error()}, []string{"The archive may be corrupt or incomplete"}, []string{"Retry the install"})
+}
+
+// ErrUnzipFile is the error for extracting zip archive
+func ErrUnzipFile(err error) error {
+	return errors.New(ErrUnzipFileCode, errors.Alert, []string{"Error extracting zip archive"}, []string{err.Error()}, []string{"The archive may be corrupt or incomplete"}, []string{"Retry the install"})
+}
+
+// ErrFetchHelmChart is the error for fetching the Istio helm chart
+func ErrFetchHelmChart(err error) error {
+	return errors.New(ErrFetchHelmChartCode, errors.Alert, []string{"Error fetching istio helm chart"}, []string{err.Error()}, []string{"The requested release asset may not exist, or GitHub releases may be unreachable"}, []string{"Check network connectivity to github.com and that the requested version exists"})
+}
+
+// ErrRenderHelmChart is the error for rendering the Istio helm chart
+func ErrRenderHelmChart(err error) error {
+	return errors.New(ErrRenderHelmChartCode, errors.Alert, []string{"Error rendering istio helm chart"}, []string{err.Error()}, []string{"The supplied values may be invalid for this chart version"}, []string{"Check the values passed to the helm install against the chart's values.yaml"})
+}
+
+// ErrChecksumMismatch is the error for when a downloaded istioctl binary does
+// not match its published checksum (signature failures are reported by
+// ErrSignatureMismatch)
+func ErrChecksumMismatch(release, expected, actual string) error {
+	return errors.New(ErrChecksumMismatchCode, errors.Alert,
+		[]string{"Downloaded istioctl binary failed checksum verification"},
+		[]string{fmt.Sprintf("release %s: expected checksum %s, got %s", release, expected, actual)},
+		[]string{"The download may have been corrupted or tampered with in transit"},
+		[]string{"Retry the download; if the mismatch persists, do not install this binary and report it"})
+}
+
+// ErrSignatureMismatch is the error for when a downloaded istioctl checksum
+// file's GPG signature does not verify against the configured signing key
+func ErrSignatureMismatch(release string, err error) error {
+	return errors.New(ErrSignatureMismatchCode, errors.Alert,
+		[]string{"Downloaded istioctl checksum signature failed verification"},
+		[]string{fmt.Sprintf("release %s: %s", release, err.Error())},
+		[]string{"The checksum file may have been tampered with, or config.IstioctlSigningKey may not match the key used to sign this release"},
+		[]string{"Do not install this binary; verify config.IstioctlSigningKey against Istio's published signing key and retry"})
+}
@@ -5,6 +5,9 @@ import (
 	"archive/zip"
 	"bytes"
 	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -13,14 +16,30 @@ import (
 	"os/exec"
 	"path"
 	"runtime"
+	"strings"
+	"time"
 
+	"github.com/gofrs/flock"
 	"github.com/layer5io/meshery-adapter-library/adapter"
 	"github.com/layer5io/meshery-adapter-library/status"
 	"github.com/layer5io/meshery-istio/internal/config"
 	mesherykube "github.com/layer5io/meshkit/utils/kubernetes"
+	"golang.org/x/crypto/openpgp"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
 )
 
-func (istio *Istio) installIstio(del bool, version, namespace string) (string, error) {
+// ProgressFunc reports percent-complete (0-100) for a long-running download
+// or install step, so callers can stream progress back to Meshery over the
+// existing operation channel.
+type ProgressFunc func(percent float64)
+
+// lockRetryInterval is how often a per-version flock acquisition re-checks
+// ctx cancellation while waiting for a concurrent install to release it.
+const lockRetryInterval = 250 * time.Millisecond
+
+func (istio *Istio) installIstio(ctx context.Context, del bool, version, namespace string, progress ProgressFunc) (string, error) {
 	istio.Log.Info(fmt.Sprintf("Requested install of version: %s", version))
 	istio.Log.Info(fmt.Sprintf("Requested action is delete: %v", del))
 	istio.Log.Info(fmt.Sprintf("Requested action is in namespace: %s", namespace))
@@ -42,13 +61,13 @@ func (istio *Istio) installIstio(del bool, version, namespace string) (string, e
 		return st, ErrMeshConfig(err)
 	}
 
-	manifest, err := istio.fetchManifest(version, del)
+	manifest, err := istio.fetchManifest(ctx, version, del, progress)
 	if err != nil {
 		istio.Log.Error(ErrInstallIstio(err))
 		return st, ErrInstallIstio(err)
 	}
 
-	err = istio.applyManifest([]byte(manifest), del, namespace)
+	err = istio.applyManifest(ctx, []byte(manifest), del, namespace)
 	if err != nil {
 		istio.Log.Error(ErrInstallIstio(err))
 		return st, ErrInstallIstio(err)
@@ -60,13 +79,19 @@ func (istio *Istio) installIstio(del bool, version, namespace string) (string, e
 	return status.Installed, nil
 }
 
-func (istio *Istio) fetchManifest(version string, isDel bool) (string, error) {
+func (istio *Istio) fetchManifest(ctx context.Context, version string, isDel bool, progress ProgressFunc) (string, error) {
+	// Helm is only used for installs today; uninstalls always go through
+	// `istioctl x uninstall`, since that's the only purge-equivalent we have.
+	if config.InstallMethod() == config.InstallMethodHelm && !isDel {
+		return istio.fetchManifestWithHelm(ctx, version)
+	}
+
 	var (
 		out bytes.Buffer
 		er  bytes.Buffer
 	)
 
-	Executable, err := istio.getExecutable(version)
+	Executable, err := istio.getExecutable(ctx, version, progress)
 	if err != nil {
 		return "", ErrFetchManifest(err, err.Error())
 	}
@@ -77,7 +102,7 @@ func (istio *Istio) fetchManifest(version string, isDel bool) (string, error) {
 
 	// We need a variable executable here hence using nosec
 	// #nosec
-	command := exec.Command(Executable, execCmd...)
+	command := exec.CommandContext(ctx, Executable, execCmd...)
 	command.Stdout = &out
 	command.Stderr = &er
 	err = command.Run()
@@ -88,7 +113,130 @@ func (istio *Istio) fetchManifest(version string, isDel bool) (string, error) {
 	return out.String(), nil
 }
 
-func (istio *Istio) applyManifest(contents []byte, isDel bool, namespace string) error {
+// fetchManifestWithHelm downloads (or reuses a cached copy of) the official
+// Istio helm chart for the given version and renders it, producing a
+// manifest equivalent to an istioctl profile install.
+//
+// TODO: this renders with chart defaults only. There's currently no surface
+// on Istio for an operator to supply custom values for a helm-mode install;
+// once one exists, thread it through here instead of the nil passed to
+// renderHelmChart.
+func (istio *Istio) fetchManifestWithHelm(ctx context.Context, version string) (string, error) {
+	chartPath, err := istio.fetchHelmChart(ctx, version)
+	if err != nil {
+		return "", ErrFetchManifest(err, err.Error())
+	}
+
+	manifest, err := renderHelmChart(chartPath, nil)
+	if err != nil {
+		return "", ErrFetchManifest(err, err.Error())
+	}
+
+	return manifest, nil
+}
+
+// fetchHelmChart downloads the official Istio helm chart manifests tarball
+// for "version" into the adapter's cache directory and returns the path to
+// the extracted chart, so repeated installs of the same version don't
+// re-download it.
+func (istio *Istio) fetchHelmChart(ctx context.Context, version string) (string, error) {
+	versionDir := path.Join(config.RootPath(), "istio-install-packages", version)
+	chartDir := path.Join(versionDir, "manifests")
+
+	if err := os.MkdirAll(versionDir, 0750); err != nil {
+		return "", ErrFetchHelmChart(err)
+	}
+
+	// A per-version lock keeps concurrent installs of the same version from
+	// racing to extract into chartDir, and keeps the cache check below from
+	// ever trusting a chartDir left half-extracted by a crashed or cancelled
+	// earlier attempt.
+	lock := flock.New(path.Join(versionDir, ".manifests.lock"))
+	locked, err := lock.TryLockContext(ctx, lockRetryInterval)
+	if err != nil {
+		return "", ErrFetchHelmChart(err)
+	}
+	if !locked {
+		return "", ErrFetchHelmChart(ctx.Err())
+	}
+	defer lock.Unlock()
+
+	if _, err := os.Stat(chartDir); err == nil {
+		istio.Log.Info("Using cached helm chart for version", version)
+		return chartDir, nil
+	}
+
+	url := fmt.Sprintf("https://github.com/istio/istio/releases/download/%s/istio-%s-manifests.tar.gz", version, version)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", ErrFetchHelmChart(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", ErrFetchHelmChart(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			istio.Log.Error(ErrFetchHelmChart(cerr))
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", ErrFetchHelmChart(fmt.Errorf("bad status: %s", resp.Status))
+	}
+
+	// Extract into a temp dir alongside chartDir and rename into place only
+	// once extraction fully succeeds, so a crash or cancelled ctx mid-extract
+	// can never leave a corrupt chartDir behind for the cache check above to
+	// trust on the next install.
+	tmpDir, err := ioutil.TempDir(versionDir, "manifests-*")
+	if err != nil {
+		return "", ErrFetchHelmChart(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if err := tarxzf(ctx, tmpDir, resp.Body, nil); err != nil {
+		return "", ErrFetchHelmChart(err)
+	}
+	if err := os.Rename(tmpDir, chartDir); err != nil {
+		return "", ErrFetchHelmChart(err)
+	}
+
+	return chartDir, nil
+}
+
+// renderHelmChart loads the chart rooted at chartPath and renders it with the
+// supplied values, returning the concatenated manifest in the same shape
+// `istioctl install` would have produced.
+func renderHelmChart(chartPath string, values map[string]interface{}) (string, error) {
+	loadedChart, err := loader.Load(chartPath)
+	if err != nil {
+		return "", ErrRenderHelmChart(err)
+	}
+
+	renderValues, err := chartutil.ToRenderValues(loadedChart, values, chartutil.ReleaseOptions{
+		Name:      "istio",
+		Namespace: "istio-system",
+	}, nil)
+	if err != nil {
+		return "", ErrRenderHelmChart(err)
+	}
+
+	rendered, err := engine.Render(loadedChart, renderValues)
+	if err != nil {
+		return "", ErrRenderHelmChart(err)
+	}
+
+	var manifest bytes.Buffer
+	for _, content := range rendered {
+		manifest.WriteString(content)
+		manifest.WriteString("\n---\n")
+	}
+
+	return manifest.String(), nil
+}
+
+func (istio *Istio) applyManifest(_ context.Context, contents []byte, isDel bool, namespace string) error {
 	kclient, err := mesherykube.New(istio.KubeClient, istio.RestConfig)
 	if err != nil {
 		return err
@@ -109,9 +257,21 @@ func (istio *Istio) applyManifest(contents []byte, isDel bool, namespace string)
 // If it doesn't find the executable in the path then it proceeds
 // to download the binary from github releases and installs it
 // in the root config path
-func (istio *Istio) getExecutable(release string) (string, error) {
+//
+// Step 2 and the download go through selectBinaryProvider: with a configured
+// local path, step 2 looks in that directory instead and nothing is
+// downloaded; with a configured mirror, the binary is downloaded from the
+// mirror instead of github releases.
+func (istio *Istio) getExecutable(ctx context.Context, release string, progress ProgressFunc) (string, error) {
 	const binaryName = "istioctl"
+	// alternateBinaryName names the install-package directory the archive
+	// extracts into; finalBinaryName (which carries the .exe suffix on
+	// Windows) is the name the binary is ultimately installed under, and
+	// must be used everywhere we look up or return an already-installed path
+	// under binPath. The $PATH lookup below uses alternateBinaryName, which
+	// exec.LookPath extends with PATHEXT suffixes such as ".exe" on Windows.
 	alternateBinaryName := "istioctl-" + release
+	finalBinaryName := istioctlFilename(release, runtime.GOOS)
 
 	// Look for the executable in the path
 	istio.Log.Info("Looking for istio in the path...")
@@ -124,61 +284,279 @@ func (istio *Istio) getExecutable(release string) (string, error) {
 		return executable, nil
 	}
 
-	// Look for config in the root path
 	binPath := path.Join(config.RootPath(), "bin")
-	istio.Log.Info("Looking for istio in", binPath, "...")
-	executable = path.Join(binPath, alternateBinaryName)
-	if _, err := os.Stat(executable); err == nil {
+	provider := selectBinaryProvider(binPath)
+
+	// Look for a previously installed binary via the configured provider
+	istio.Log.Info(fmt.Sprintf("Looking for istio via %s...", provider.Name()))
+	if executable, err := provider.Resolve(release); err == nil {
 		return executable, nil
 	}
 
-	// Proceed to download the binary in the config root path
-	istio.Log.Info("istio not found in the path, downloading...")
-	res, err := downloadBinary(runtime.GOOS, runtime.GOARCH, release)
+	// A per-version lock keeps concurrent installs of the same release from
+	// racing to download and rename the same file. Acquire it against ctx
+	// rather than blocking uncancellably, so an aborted operation doesn't
+	// hang here waiting out whoever currently holds the lock.
+	lock := flock.New(path.Join(binPath, fmt.Sprintf(".istioctl-%s.lock", release)))
+	if err := os.MkdirAll(binPath, 0750); err != nil {
+		return "", ErrDownloadBinary(err)
+	}
+	locked, err := lock.TryLockContext(ctx, lockRetryInterval)
+	if err != nil {
+		return "", ErrDownloadBinary(err)
+	}
+	if !locked {
+		return "", ErrDownloadBinary(ctx.Err())
+	}
+	defer lock.Unlock()
+
+	// Someone may have finished installing this release while we waited on the lock.
+	if executable, err := provider.Resolve(release); err == nil {
+		return executable, nil
+	}
+
+	// Proceed to fetch the binary via the configured provider
+	istio.Log.Info(fmt.Sprintf("istio not found, fetching via %s...", provider.Name()))
+	res, err := provider.Fetch(ctx, release, runtime.GOOS, runtime.GOARCH)
 	if err != nil {
 		return "", err
 	}
 	// Install the binary
 	istio.Log.Info("Installing...")
-	if err = installBinary(path.Join(binPath, alternateBinaryName), runtime.GOOS, res); err != nil {
+	if err = installBinary(ctx, path.Join(binPath, alternateBinaryName), runtime.GOOS, res, progress); err != nil {
 		return "", err
 	}
-	if err := extractAndClean(binPath, alternateBinaryName, runtime.GOOS); err != nil {
+	if err := extractAndClean(binPath, alternateBinaryName, finalBinaryName, runtime.GOOS); err != nil {
 		return "", err
 	}
 
 	istio.Log.Info("Done")
-	return path.Join(binPath, alternateBinaryName), nil
+	return path.Join(binPath, finalBinaryName), nil
 }
 
-func downloadBinary(platform, arch, release string) (*http.Response, error) {
+// istioctlFilename returns the name istioctl for "release" is installed
+// under on "platform" — e.g. "istioctl-1.20.0" on linux/darwin, or
+// "istioctl-1.20.0.exe" on windows, so every lookup and provider agrees on
+// what the installed file is actually called.
+func istioctlFilename(release, platform string) string {
+	name := "istioctl-" + release
+	if platform == "windows" {
+		name += ".exe"
+	}
+	return name
+}
+
+// assetURL builds the GitHub release URL for the istioctl archive matching
+// platform/arch/release.
+func assetURL(platform, arch, release string) string {
 	var url = "https://github.com/istio/istio/releases/download"
 	switch platform {
 	case "darwin":
-		url = fmt.Sprintf("%s/%s/istioctl-%s-osx.tar.gz", url, release, release)
+		osxName := "osx"
+		if arch == "arm64" {
+			osxName = "osx-arm64"
+		}
+		url = fmt.Sprintf("%s/%s/istioctl-%s-%s.tar.gz", url, release, release, osxName)
 	case "windows":
 		url = fmt.Sprintf("%s/%s/istioctl-%s-win.zip", url, release, release)
 	case "linux":
 		url = fmt.Sprintf("%s/%s/istioctl-%s-%s-%s.tar.gz", url, release, release, platform, arch)
 	}
+	return url
+}
 
-	resp, err := http.Get(url)
+// downloadChecksum fetches the sibling SHA256 checksum file GitHub publishes
+// alongside every istioctl release asset and returns the expected,
+// hex-encoded digest.
+func downloadChecksum(ctx context.Context, platform, arch, release string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, assetURL(platform, arch, release)+".sha256", nil)
 	if err != nil {
-		return nil, ErrDownloadBinary(err)
+		return "", ErrDownloadBinary(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", ErrDownloadBinary(err)
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		resp.Body.Close()
+		return "", ErrDownloadBinary(fmt.Errorf("bad status fetching checksum: %s", resp.Status))
+	}
+
+	contents, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", ErrDownloadBinary(err)
+	}
+
+	fields := strings.Fields(string(contents))
+	if len(fields) == 0 {
+		return "", ErrDownloadBinary(fmt.Errorf("checksum file for %s is empty", release))
+	}
+
+	return strings.ToLower(fields[0]), nil
+}
+
+// downloadBinary downloads the istioctl archive for platform/arch/release,
+// resuming from any previously interrupted attempt via an HTTP Range
+// request, then verifies it against the published SHA256 checksum (and, if
+// config.IstioctlSigningKey is set, the checksum file's GPG signature) and
+// returns a reader over the verified payload.
+func downloadBinary(ctx context.Context, platform, arch, release string) (io.ReadCloser, error) {
+	partPath := path.Join(os.TempDir(), fmt.Sprintf(".istioctl-%s-%s-%s.download", release, platform, arch))
+
+	payload, err := resumeDownload(ctx, partPath, assetURL(platform, arch, release))
+	if err != nil {
+		return nil, err
+	}
+
+	expectedSum, err := downloadChecksum(ctx, platform, arch, release)
+	if err != nil {
+		payload.Close()
+		return nil, err
+	}
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, payload); err != nil {
+		payload.Close()
+		return nil, ErrDownloadBinary(err)
+	}
+
+	actualSum := hex.EncodeToString(hasher.Sum(nil))
+	if actualSum != expectedSum {
+		payload.Close()
+		os.Remove(partPath)
+		return nil, ErrChecksumMismatch(release, expectedSum, actualSum)
+	}
+
+	if key := config.IstioctlSigningKey(); key != "" {
+		if err := verifyChecksumSignature(ctx, platform, arch, release, key); err != nil {
+			payload.Close()
+			return nil, err
+		}
+	}
+
+	if _, err := payload.Seek(0, io.SeekStart); err != nil {
+		payload.Close()
+		return nil, ErrDownloadBinary(err)
+	}
+	// Unlink the cached .download file now that it's verified; the open
+	// descriptor keeps its contents readable on POSIX (on Windows the
+	// removal is a no-op until the caller closes it, which is fine too).
+	defer os.Remove(partPath)
+
+	return payload, nil
+}
+
+// resumeDownload downloads url to partPath, resuming from any bytes already
+// on disk (e.g. left over from a prior attempt that crashed or was
+// cancelled) via an HTTP Range request. It returns the file positioned at
+// the start, ready to read.
+func resumeDownload(ctx context.Context, partPath, url string) (*os.File, error) {
+	var offset int64
+	if info, err := os.Stat(partPath); err == nil {
+		offset = info.Size()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, ErrDownloadBinary(err)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, ErrDownloadBinary(err)
+	}
+	defer resp.Body.Close()
+
+	flags := os.O_CREATE | os.O_RDWR
+	switch resp.StatusCode {
+	case http.StatusRequestedRangeNotSatisfiable:
+		// Every byte was already on disk from the prior attempt; nothing left to fetch.
+		if offset > 0 {
+			out, err := os.Open(partPath)
+			if err != nil {
+				return nil, ErrDownloadBinary(err)
+			}
+			return out, nil
+		}
+		return nil, ErrDownloadBinary(fmt.Errorf("bad status: %s", resp.Status))
+	case http.StatusPartialContent:
+		flags |= os.O_APPEND
+	case http.StatusOK:
+		// Server ignored our Range request (or there was nothing to resume); start over.
+		flags |= os.O_TRUNC
+	default:
 		return nil, ErrDownloadBinary(fmt.Errorf("bad status: %s", resp.Status))
 	}
 
-	return resp, nil
+	out, err := os.OpenFile(partPath, flags, 0640)
+	if err != nil {
+		return nil, ErrDownloadBinary(err)
+	}
+
+	if _, err := io.Copy(out, resp.Body); err != nil {
+		out.Close()
+		return nil, ErrDownloadBinary(err)
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return nil, ErrDownloadBinary(err)
+	}
+	if _, err := out.Seek(0, io.SeekStart); err != nil {
+		out.Close()
+		return nil, ErrDownloadBinary(err)
+	}
+
+	return out, nil
+}
+
+// verifyChecksumSignature verifies the detached GPG signature (the sibling
+// ".sha256.asc" release asset) of the checksum file against signingKey, which
+// is expected to be an ASCII-armored public key.
+func verifyChecksumSignature(ctx context.Context, platform, arch, release, signingKey string) error {
+	sigReq, err := http.NewRequestWithContext(ctx, http.MethodGet, assetURL(platform, arch, release)+".sha256.asc", nil)
+	if err != nil {
+		return ErrDownloadBinary(err)
+	}
+	sigResp, err := http.DefaultClient.Do(sigReq)
+	if err != nil {
+		return ErrDownloadBinary(err)
+	}
+	defer sigResp.Body.Close()
+
+	if sigResp.StatusCode != http.StatusOK {
+		return ErrDownloadBinary(fmt.Errorf("bad status fetching checksum signature: %s", sigResp.Status))
+	}
+
+	keyring, err := openpgp.ReadArmoredKeyRing(strings.NewReader(signingKey))
+	if err != nil {
+		return ErrDownloadBinary(err)
+	}
+
+	sumReq, err := http.NewRequestWithContext(ctx, http.MethodGet, assetURL(platform, arch, release)+".sha256", nil)
+	if err != nil {
+		return ErrDownloadBinary(err)
+	}
+	sumResp, err := http.DefaultClient.Do(sumReq)
+	if err != nil {
+		return ErrDownloadBinary(err)
+	}
+	defer sumResp.Body.Close()
+
+	if _, err := openpgp.CheckArmoredDetachedSignature(keyring, sumResp.Body, sigResp.Body, nil); err != nil {
+		return ErrSignatureMismatch(release, err)
+	}
+
+	return nil
 }
 
-func installBinary(location, platform string, res *http.Response) error {
-	// Close the response body
+func installBinary(ctx context.Context, location, platform string, body io.ReadCloser, progress ProgressFunc) error {
+	// Close the downloaded payload
 	defer func() {
-		if err := res.Body.Close(); err != nil {
+		if err := body.Close(); err != nil {
 			fmt.Println(err)
 		}
 	}()
@@ -192,26 +570,69 @@ func installBinary(location, platform string, res *http.Response) error {
 	case "darwin":
 		fallthrough
 	case "linux":
-		if err := tarxzf(location, res.Body); err != nil {
+		if err := tarxzf(ctx, location, body, progress); err != nil {
 			return ErrInstallBinary(err)
 		}
 	case "windows":
-		if err := unzip(location, res.Body); err != nil {
+		if err := unzip(ctx, location, body, progress); err != nil {
 			return ErrInstallBinary(err)
 		}
 	}
+
+	if progress != nil {
+		progress(100)
+	}
 	return nil
 }
 
-func tarxzf(location string, stream io.Reader) error {
+// safeJoin joins name onto location and rejects the result if it would
+// escape location, guarding against zip-slip-style archive entries such as
+// "../../evil" or an absolute path. tar/zip entry names are always
+// "/"-separated regardless of the host OS, so name is rejected outright if
+// it contains a literal "\" or a drive letter ("C:") - on Windows those are
+// real path separators/roots to os.MkdirAll and os.Create even though the
+// "/"-only containment check below would never see a ".." segment in them.
+func safeJoin(location, name string) (string, error) {
+	if strings.ContainsRune(name, '\\') || path.IsAbs(name) || hasDriveLetterPrefix(name) {
+		return "", fmt.Errorf("illegal file path in archive: %q", name)
+	}
+
+	joined := path.Join(location, name)
+	cleanLocation := path.Clean(location)
+	// joined/cleanLocation come from the "path" package, which always uses
+	// "/" regardless of OS, so the boundary check must too - os.PathSeparator
+	// would be "\" on Windows and never match, rejecting every entry.
+	if joined != cleanLocation && !strings.HasPrefix(joined, cleanLocation+"/") {
+		return "", fmt.Errorf("illegal file path in archive: %q", name)
+	}
+	return joined, nil
+}
+
+// hasDriveLetterPrefix reports whether name starts with a Windows drive
+// letter ("C:", "d:", ...), which path.IsAbs doesn't recognize as absolute
+// but which Windows resolves as a volume root regardless.
+func hasDriveLetterPrefix(name string) bool {
+	if len(name) < 2 || name[1] != ':' {
+		return false
+	}
+	c := name[0]
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func tarxzf(ctx context.Context, location string, stream io.Reader, progress ProgressFunc) error {
 	uncompressedStream, err := gzip.NewReader(stream)
 	if err != nil {
 		return err
 	}
 
 	tarReader := tar.NewReader(uncompressedStream)
+	entries := 0
 
 	for {
+		if err := ctx.Err(); err != nil {
+			return ErrTarXZF(err)
+		}
+
 		header, err := tarReader.Next()
 
 		if err == io.EOF {
@@ -222,17 +643,21 @@ func tarxzf(location string, stream io.Reader) error {
 			return ErrTarXZF(err)
 		}
 
+		target, err := safeJoin(location, header.Name)
+		if err != nil {
+			return ErrTarXZF(err)
+		}
+
 		switch header.Typeflag {
 		case tar.TypeDir:
-			// File traversal is required to store the binary at the right place
-			// #nosec
-			if err := os.MkdirAll(path.Join(location, header.Name), 0750); err != nil {
+			if err := os.MkdirAll(target, 0750); err != nil {
 				return ErrTarXZF(err)
 			}
 		case tar.TypeReg:
-			// File traversal is required to store the binary at the right place
-			// #nosec
-			outFile, err := os.Create(path.Join(location, header.Name))
+			if err := os.MkdirAll(path.Dir(target), 0750); err != nil {
+				return ErrTarXZF(err)
+			}
+			outFile, err := os.Create(target)
 			if err != nil {
 				return ErrTarXZF(err)
 			}
@@ -244,65 +669,170 @@ func tarxzf(location string, stream io.Reader) error {
 			if err = outFile.Close(); err != nil {
 				return ErrTarXZF(err)
 			}
+		case tar.TypeSymlink:
+			linkTarget, err := safeJoin(location, header.Linkname)
+			if err != nil {
+				return ErrTarXZF(err)
+			}
+			if err := os.Symlink(linkTarget, target); err != nil {
+				return ErrTarXZF(err)
+			}
 
 		default:
 			return ErrTarXZF(err)
 		}
+
+		entries++
+		if progress != nil {
+			// Total entry count isn't known up front for a streamed tar, so
+			// report a capped heartbeat rather than a true percentage.
+			heartbeat := entries * 10
+			if heartbeat > 99 {
+				heartbeat = 99
+			}
+			progress(float64(heartbeat))
+		}
 	}
 
 	return nil
 }
 
-func unzip(location string, zippedContent io.Reader) error {
-	// Keep file in memory: Approx size ~ 50MB
-	// TODO: Find a better approach
-	zipped, err := ioutil.ReadAll(zippedContent)
+// unzip streams zippedContent to a temporary file and extracts it with
+// zip.OpenReader, so memory use stays bounded regardless of archive size.
+func unzip(ctx context.Context, location string, zippedContent io.Reader, progress ProgressFunc) error {
+	tmpFile, err := ioutil.TempFile("", "istioctl-*.zip")
+	if err != nil {
+		return ErrUnzipFile(err)
+	}
+	tmpPath := tmpFile.Name()
+	defer os.Remove(tmpPath)
+
+	if _, err := io.Copy(tmpFile, zippedContent); err != nil {
+		tmpFile.Close()
+		return ErrUnzipFile(err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return ErrUnzipFile(err)
+	}
 
-	zReader, err := zip.NewReader(bytes.NewReader(zipped), int64(len(zipped)))
+	zReader, err := zip.OpenReader(tmpPath)
 	if err != nil {
 		return ErrUnzipFile(err)
 	}
+	defer zReader.Close()
 
-	for _, file := range zReader.File {
-		zippedFile, err := file.Open()
-		if err != nil {
+	for i, file := range zReader.File {
+		if err := ctx.Err(); err != nil {
 			return ErrUnzipFile(err)
 		}
-		defer zippedFile.Close()
 
-		extractedFilePath := path.Join(location, file.Name)
+		target, err := safeJoin(location, file.Name)
+		if err != nil {
+			return ErrUnzipFile(err)
+		}
 
 		if file.FileInfo().IsDir() {
-			os.MkdirAll(extractedFilePath, file.Mode())
-		} else {
-			outputFile, err := os.OpenFile(
-				extractedFilePath,
-				os.O_WRONLY|os.O_CREATE|os.O_TRUNC,
-				file.Mode(),
-			)
-			if err != nil {
+			if err := os.MkdirAll(target, file.Mode()); err != nil {
 				return ErrUnzipFile(err)
 			}
-			defer outputFile.Close()
+			continue
+		}
 
-			_, err = io.Copy(outputFile, zippedFile)
-			if err != nil {
+		if err := os.MkdirAll(path.Dir(target), 0750); err != nil {
+			return ErrUnzipFile(err)
+		}
+
+		if file.Mode()&os.ModeSymlink != 0 {
+			if err := extractZipSymlink(location, file, target); err != nil {
 				return ErrUnzipFile(err)
 			}
+			continue
+		}
+
+		if err := extractZipFile(file, target); err != nil {
+			return ErrUnzipFile(err)
+		}
+
+		if progress != nil && len(zReader.File) > 0 {
+			progress(float64(i+1) / float64(len(zReader.File)) * 100)
 		}
 	}
 
 	return nil
 }
 
-func extractAndClean(location, binName, platform string) error {
+// extractZipFile copies a single regular-file zip entry to target.
+func extractZipFile(file *zip.File, target string) error {
+	zippedFile, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer zippedFile.Close()
+
+	outputFile, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, file.Mode())
+	if err != nil {
+		return err
+	}
+	defer outputFile.Close()
+
+	_, err = io.Copy(outputFile, zippedFile)
+	return err
+}
+
+// extractZipSymlink recreates a symlink zip entry, resolving its target
+// against location so it cannot be used to point outside the extraction
+// directory.
+func extractZipSymlink(location string, file *zip.File, target string) error {
+	zippedFile, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer zippedFile.Close()
+
+	linkname, err := ioutil.ReadAll(zippedFile)
+	if err != nil {
+		return err
+	}
+
+	resolvedTarget, err := safeJoin(location, string(linkname))
+	if err != nil {
+		return err
+	}
+
+	return os.Symlink(resolvedTarget, target)
+}
+
+// atomicRename moves src to dst, fsyncing src first so the final binary
+// can't be left truncated by a crash mid-rename.
+func atomicRename(src, dst string) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(src, dst)
+}
+
+// extractAndClean moves the istioctl binary out of the archive's extracted
+// package directory (binName) and installs it at location under finalName —
+// the caller-supplied name that already carries the platform-appropriate
+// extension (".exe" on windows), so every subsequent lookup of the
+// installed binary agrees on what it's called.
+func extractAndClean(location, binName, finalName, platform string) error {
 	platformSpecificName := "istioctl"
 	if platform == "windows" {
 		platformSpecificName += ".exe"
 	}
 
 	// Move binary to the right location
-	err := os.Rename(path.Join(location, binName, platformSpecificName), path.Join(location, platformSpecificName))
+	err := atomicRename(path.Join(location, binName, platformSpecificName), path.Join(location, platformSpecificName))
 	if err != nil {
 		return err
 	}
@@ -312,10 +842,7 @@ func extractAndClean(location, binName, platform string) error {
 		return err
 	}
 
-	if platform == "windows" {
-		binName += ".exe"
-	}
-	if err = os.Rename(path.Join(location, platformSpecificName), path.Join(location, binName)); err != nil {
+	if err = atomicRename(path.Join(location, platformSpecificName), path.Join(location, finalName)); err != nil {
 		return err
 	}
 
@@ -326,7 +853,7 @@ func extractAndClean(location, binName, platform string) error {
 		// Set permissions
 		// Permsission has to be +x to be able to run the binary
 		// #nosec
-		if err = os.Chmod(path.Join(location, binName), 0750); err != nil {
+		if err = os.Chmod(path.Join(location, finalName), 0750); err != nil {
 			return err
 		}
 	}
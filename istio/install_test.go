@@ -0,0 +1,178 @@
+package istio
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestSafeJoinRejectsEscapes(t *testing.T) {
+	tests := []struct {
+		name     string
+		location string
+		entry    string
+		wantErr  bool
+	}{
+		{"plain file", "/tmp/istio-install", "istioctl", false},
+		{"nested file", "/tmp/istio-install", "bin/istioctl", false},
+		{"dot-relative escape", "/tmp/istio-install", "../../evil", true},
+		{"deeply nested escape", "/tmp/istio-install", "bin/../../../evil", true},
+		{"absolute path", "/tmp/istio-install", "/etc/passwd", true},
+		{"escape disguised with trailing content", "/tmp/istio-install", "../istio-installevil", true},
+		{"windows-style backslash escape", "/tmp/istio-install", "..\\..\\evil.exe", true},
+		{"windows drive letter", "/tmp/istio-install", "C:\\evil.exe", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := safeJoin(tt.location, tt.entry)
+			if tt.wantErr && err == nil {
+				t.Fatalf("safeJoin(%q, %q): expected error, got nil", tt.location, tt.entry)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("safeJoin(%q, %q): unexpected error: %v", tt.location, tt.entry, err)
+			}
+		})
+	}
+}
+
+// buildTarGz builds an in-memory tar.gz archive containing a single entry
+// with the given name, mimicking a crafted malicious istioctl release asset.
+func buildTarGz(t *testing.T, name string) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	content := []byte("evil payload")
+	if err := tw.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0600,
+		Size: int64(len(content)),
+	}); err != nil {
+		t.Fatalf("writing tar header: %v", err)
+	}
+	if _, err := tw.Write(content); err != nil {
+		t.Fatalf("writing tar content: %v", err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("closing tar writer: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("closing gzip writer: %v", err)
+	}
+	return &buf
+}
+
+func TestTarxzfRejectsZipSlip(t *testing.T) {
+	dest := t.TempDir()
+	archive := buildTarGz(t, "../../evil")
+
+	err := tarxzf(context.Background(), dest, archive, nil)
+	if err == nil {
+		t.Fatal("tarxzf: expected error extracting an archive entry escaping the destination, got nil")
+	}
+	if !strings.Contains(err.Error(), "illegal file path in archive") {
+		t.Fatalf("tarxzf: expected zip-slip rejection, got: %v", err)
+	}
+
+	if _, statErr := os.Stat(path.Join(dest, "..", "..", "evil")); statErr == nil {
+		t.Fatal("tarxzf: escaping entry should not have been written to disk")
+	}
+}
+
+// buildZip builds an in-memory zip archive containing a single entry with
+// the given name, mimicking a crafted malicious istioctl release asset.
+func buildZip(t *testing.T, name string) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	w, err := zw.Create(name)
+	if err != nil {
+		t.Fatalf("creating zip entry: %v", err)
+	}
+	if _, err := w.Write([]byte("evil payload")); err != nil {
+		t.Fatalf("writing zip content: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("closing zip writer: %v", err)
+	}
+	return &buf
+}
+
+func TestUnzipRejectsZipSlip(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry string
+	}{
+		{"forward-slash escape", "../../evil"},
+		{"windows-style backslash escape", "..\\..\\evil.exe"},
+		{"windows drive letter", "C:\\evil.exe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dest := t.TempDir()
+			archive := buildZip(t, tt.entry)
+
+			err := unzip(context.Background(), dest, bytes.NewReader(archive.Bytes()), nil)
+			if err == nil {
+				t.Fatalf("unzip(%q): expected error extracting an archive entry escaping the destination, got nil", tt.entry)
+			}
+			if !strings.Contains(err.Error(), "illegal file path in archive") {
+				t.Fatalf("unzip(%q): expected zip-slip rejection, got: %v", tt.entry, err)
+			}
+		})
+	}
+}
+
+func TestIstioctlFilename(t *testing.T) {
+	tests := []struct {
+		platform string
+		want     string
+	}{
+		{"linux", "istioctl-1.20.0"},
+		{"darwin", "istioctl-1.20.0"},
+		{"windows", "istioctl-1.20.0.exe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.platform, func(t *testing.T) {
+			if got := istioctlFilename("1.20.0", tt.platform); got != tt.want {
+				t.Errorf("istioctlFilename(%q, %q) = %q, want %q", "1.20.0", tt.platform, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAssetURL(t *testing.T) {
+	const release = "1.20.0"
+	tests := []struct {
+		platform string
+		arch     string
+		want     string
+	}{
+		{"linux", "amd64", "https://github.com/istio/istio/releases/download/1.20.0/istioctl-1.20.0-linux-amd64.tar.gz"},
+		{"linux", "arm64", "https://github.com/istio/istio/releases/download/1.20.0/istioctl-1.20.0-linux-arm64.tar.gz"},
+		{"darwin", "amd64", "https://github.com/istio/istio/releases/download/1.20.0/istioctl-1.20.0-osx.tar.gz"},
+		{"darwin", "arm64", "https://github.com/istio/istio/releases/download/1.20.0/istioctl-1.20.0-osx-arm64.tar.gz"},
+		{"windows", "amd64", "https://github.com/istio/istio/releases/download/1.20.0/istioctl-1.20.0-win.zip"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.platform+"/"+tt.arch, func(t *testing.T) {
+			if got := assetURL(tt.platform, tt.arch, release); got != tt.want {
+				t.Errorf("assetURL(%q, %q, %q) = %q, want %q", tt.platform, tt.arch, release, got, tt.want)
+			}
+		})
+	}
+}